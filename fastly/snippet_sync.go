@@ -0,0 +1,165 @@
+package fastly
+
+import "fmt"
+
+// SnippetSyncInput is used as input to the SnippetSync function.
+type SnippetSyncInput struct {
+	// Service is the ID of the service (required).
+	Service string
+
+	// Desired is the full set of VCL Snippets that should exist on the
+	// service once the sync completes.
+	Desired []*VCLSnippet
+
+	// Prune, when true, deletes any existing snippet that is not present in
+	// Desired. When false, snippets absent from Desired are left untouched.
+	Prune bool
+
+	// Activate, when true, activates the new version once the snippets have
+	// been applied and validated. When false, the cloned version is left
+	// inactive so the caller can inspect or extend it further.
+	Activate bool
+}
+
+// SnippetSyncReport describes the effect SnippetSync had on each snippet
+// it considered.
+type SnippetSyncReport struct {
+	Created   []string
+	Updated   []string
+	Deleted   []string
+	Unchanged []string
+}
+
+// SnippetSync applies a desired set of VCL Snippets to a service using the
+// clone-diff-activate pattern: it clones the service's latest version (as
+// returned by LatestVersion, which need not be the active one), creates,
+// updates, and (optionally) deletes snippets so the version matches Desired,
+// validates the result, and optionally activates it. It returns the number
+// of the version the changes were made on and a report of what changed.
+//
+// Existing dynamic snippets are updated through UpdateDynamicVCLSnippet,
+// which sets Content only: a Priority or Type difference on a dynamic
+// snippet is reported as Updated but is not applied.
+func (c *Client) SnippetSync(i *SnippetSyncInput) (int, *SnippetSyncReport, error) {
+	if i.Service == "" {
+		return 0, nil, ErrMissingService
+	}
+
+	active, err := c.LatestVersion(&LatestVersionInput{
+		Service: i.Service,
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	clone, err := c.CloneVersion(&CloneVersionInput{
+		Service: i.Service,
+		Version: active.Number,
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	existing, err := c.ListVCLSnippets(&ListVCLSnippetsInput{
+		Service: i.Service,
+		Version: clone.Number,
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	byName := make(map[string]*VCLSnippet, len(existing))
+	for _, s := range existing {
+		byName[s.Name] = s
+	}
+
+	report := &SnippetSyncReport{}
+
+	seen := make(map[string]bool, len(i.Desired))
+	for _, want := range i.Desired {
+		seen[want.Name] = true
+
+		have, ok := byName[want.Name]
+		if !ok {
+			dynamic := Compatibool(want.Dynamic)
+			if _, err := c.CreateVCLSnippet(&CreateVCLSnippetInput{
+				ServiceID: i.Service,
+				Version:   clone.Number,
+				Name:      want.Name,
+				Content:   want.Content,
+				Priority:  want.Priority,
+				Type:      want.Type,
+				Dynamic:   &dynamic,
+			}); err != nil {
+				return 0, nil, err
+			}
+			report.Created = append(report.Created, want.Name)
+			continue
+		}
+
+		if have.EqualIgnoringMeta(want) {
+			report.Unchanged = append(report.Unchanged, want.Name)
+			continue
+		}
+
+		if have.Dynamic {
+			if _, err := c.UpdateDynamicVCLSnippet(&UpdateDynamicVCLSnippetInput{
+				ServiceID: i.Service,
+				SnippetID: have.ID,
+				Content:   want.Content,
+			}); err != nil {
+				return 0, nil, err
+			}
+		} else {
+			if _, err := c.UpdateVCLSnippet(&UpdateVCLSnippetInput{
+				ServiceID: i.Service,
+				Version:   clone.Number,
+				Name:      want.Name,
+				Content:   want.Content,
+				Priority:  want.Priority,
+				Type:      want.Type,
+			}); err != nil {
+				return 0, nil, err
+			}
+		}
+		report.Updated = append(report.Updated, want.Name)
+	}
+
+	if i.Prune {
+		for name := range byName {
+			if seen[name] {
+				continue
+			}
+			if err := c.DeleteVCLSnippet(&DeleteVCLSnippetInput{
+				Service: i.Service,
+				Version: clone.Number,
+				Name:    name,
+			}); err != nil {
+				return 0, nil, err
+			}
+			report.Deleted = append(report.Deleted, name)
+		}
+	}
+
+	ok, msg, err := c.ValidateVersion(&ValidateVersionInput{
+		Service: i.Service,
+		Version: clone.Number,
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	if !ok {
+		return 0, nil, fmt.Errorf("invalid VCL after sync: %s", msg)
+	}
+
+	if i.Activate {
+		if _, err := c.ActivateVersion(&ActivateVersionInput{
+			Service: i.Service,
+			Version: clone.Number,
+		}); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	return clone.Number, report, nil
+}
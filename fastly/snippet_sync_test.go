@@ -0,0 +1,183 @@
+package fastly
+
+import (
+	"testing"
+)
+
+func TestClient_SnippetSync(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var tv *Version
+	record(t, "snippet_sync/version", func(c *Client) {
+		tv = testVersion(t, c)
+	})
+
+	// Seed a snippet we'll update and one we'll prune, on the test version
+	// (the service's latest) so SnippetSync has to discover them after cloning.
+	record(t, "snippet_sync/seed_update", func(c *Client) {
+		_, err = c.CreateVCLSnippet(&CreateVCLSnippetInput{
+			ServiceID: testServiceID,
+			Version:   tv.Number,
+			Name:      "to-update",
+			Type:      VCLSnippetTypeRecv,
+			Priority:  50,
+			Content:   "sub vcl_recv {\n #old\n}",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	record(t, "snippet_sync/seed_prune", func(c *Client) {
+		_, err = c.CreateVCLSnippet(&CreateVCLSnippetInput{
+			ServiceID: testServiceID,
+			Version:   tv.Number,
+			Name:      "to-prune",
+			Type:      VCLSnippetTypeRecv,
+			Priority:  50,
+			Content:   "sub vcl_recv {\n #gone\n}",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Seed a dynamic snippet too, so the update path has to pick
+	// UpdateDynamicVCLSnippet over UpdateVCLSnippet.
+	dynamic := Compatibool(true)
+	var seededDynamic *VCLSnippet
+	record(t, "snippet_sync/seed_dynamic", func(c *Client) {
+		seededDynamic, err = c.CreateVCLSnippet(&CreateVCLSnippetInput{
+			ServiceID: testServiceID,
+			Version:   tv.Number,
+			Name:      "to-update-dynamic",
+			Type:      VCLSnippetTypeRecv,
+			Priority:  50,
+			Content:   "sub vcl_recv {\n #old-dynamic\n}",
+			Dynamic:   &dynamic,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var version int
+	var report *SnippetSyncReport
+	record(t, "snippet_sync/sync", func(c *Client) {
+		version, report, err = c.SnippetSync(&SnippetSyncInput{
+			Service: testServiceID,
+			Desired: []*VCLSnippet{
+				{
+					Name:     "to-update",
+					Type:     VCLSnippetTypeRecv,
+					Priority: 50,
+					Content:  "sub vcl_recv {\n #new\n}",
+				},
+				{
+					Name:     "to-update-dynamic",
+					Type:     VCLSnippetTypeRecv,
+					Priority: 50,
+					Content:  "sub vcl_recv {\n #new-dynamic\n}",
+				},
+				{
+					Name:     "brand-new",
+					Type:     VCLSnippetTypeRecv,
+					Priority: 50,
+					Content:  "sub vcl_recv {\n #brand-new\n}",
+				},
+			},
+			Prune: true,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if version == tv.Number {
+		t.Errorf("expected SnippetSync to operate on a cloned version")
+	}
+
+	if !contains(report.Created, "brand-new") {
+		t.Errorf("expected brand-new to be created: %v", report.Created)
+	}
+	if !contains(report.Updated, "to-update") {
+		t.Errorf("expected to-update to be updated: %v", report.Updated)
+	}
+	if !contains(report.Updated, "to-update-dynamic") {
+		t.Errorf("expected to-update-dynamic to be updated: %v", report.Updated)
+	}
+	if !contains(report.Deleted, "to-prune") {
+		t.Errorf("expected to-prune to be deleted: %v", report.Deleted)
+	}
+
+	// The dynamic snippet's content must be reachable through the
+	// version-less dynamic endpoint, proving SnippetSync updated it via
+	// UpdateDynamicVCLSnippet rather than the version-scoped PUT.
+	var gotDynamic *DynamicVCLSnippet
+	record(t, "snippet_sync/get_dynamic", func(c *Client) {
+		gotDynamic, err = c.GetDynamicVCLSnippet(&GetDynamicVCLSnippetInput{
+			Service:   testServiceID,
+			SnippetID: seededDynamic.ID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotDynamic.Content != "sub vcl_recv {\n #new-dynamic\n}" {
+		t.Errorf("expected dynamic snippet content to be updated via the dynamic endpoint, got: %q", gotDynamic.Content)
+	}
+
+	// Re-running against the same desired state (minus the pruned snippet)
+	// should report everything unchanged, proving update calls are skipped
+	// once content converges.
+	var rerun *SnippetSyncReport
+	record(t, "snippet_sync/sync_unchanged", func(c *Client) {
+		_, rerun, err = c.SnippetSync(&SnippetSyncInput{
+			Service: testServiceID,
+			Desired: []*VCLSnippet{
+				{
+					Name:     "to-update",
+					Type:     VCLSnippetTypeRecv,
+					Priority: 50,
+					Content:  "sub vcl_recv {\n #new\n}",
+				},
+				{
+					Name:     "to-update-dynamic",
+					Type:     VCLSnippetTypeRecv,
+					Priority: 50,
+					Content:  "sub vcl_recv {\n #new-dynamic\n}",
+				},
+				{
+					Name:     "brand-new",
+					Type:     VCLSnippetTypeRecv,
+					Priority: 50,
+					Content:  "sub vcl_recv {\n #brand-new\n}",
+				},
+			},
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !contains(rerun.Unchanged, "to-update") || !contains(rerun.Unchanged, "brand-new") || !contains(rerun.Unchanged, "to-update-dynamic") {
+		t.Errorf("expected all three snippets to be unchanged on rerun: %v", rerun.Unchanged)
+	}
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func TestClient_SnippetSync_validation(t *testing.T) {
+	var err error
+	_, _, err = testClient.SnippetSync(&SnippetSyncInput{})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+}
@@ -0,0 +1,299 @@
+package fastly
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// VCL represents a custom VCL file belonging to a Service Version.
+type VCL struct {
+	ServiceID string `mapstructure:"service_id"`
+	Version   int    `mapstructure:"version"`
+
+	Content   string     `mapstructure:"content"`
+	Main      bool       `mapstructure:"main"`
+	Name      string     `mapstructure:"name"`
+	CreatedAt *time.Time `mapstructure:"created_at"`
+	UpdatedAt *time.Time `mapstructure:"updated_at"`
+	DeletedAt *time.Time `mapstructure:"deleted_at"`
+}
+
+// ContentHash returns a SHA1 hex digest of the VCL's Content, allowing
+// callers to detect drift without diffing the (potentially large) body.
+func (v *VCL) ContentHash() string {
+	return fmt.Sprintf("%x", sha1.Sum([]byte(v.Content)))
+}
+
+// EqualIgnoringMeta compares v against other by Name, Main, and a hash of
+// Content, so a caller can tell whether re-uploading would actually change
+// anything without having to diff CreatedAt/UpdatedAt/Version first.
+func (v *VCL) EqualIgnoringMeta(other *VCL) bool {
+	return v.Name == other.Name &&
+		v.Main == other.Main &&
+		v.ContentHash() == other.ContentHash()
+}
+
+// vclsByName is a sortable list of VCLs.
+type vclsByName []*VCL
+
+// Len, Swap, and Less implement the sortable interface.
+func (v vclsByName) Len() int      { return len(v) }
+func (v vclsByName) Swap(i, j int) { v[i], v[j] = v[j], v[i] }
+func (v vclsByName) Less(i, j int) bool {
+	return v[i].Name < v[j].Name
+}
+
+// ListVCLsInput is used as input to the ListVCLs function.
+type ListVCLsInput struct {
+	// Service is the ID of the service (required).
+	Service string
+
+	// Version is the specific configuration version (required).
+	Version int
+}
+
+// ListVCLs returns the list of VCLs for the configuration version.
+func (c *Client) ListVCLs(i *ListVCLsInput) ([]*VCL, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/vcl", i.Service, i.Version)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var vs []*VCL
+	if err := decodeJSON(&vs, resp.Body); err != nil {
+		return nil, err
+	}
+	sort.Stable(vclsByName(vs))
+	return vs, nil
+}
+
+// GetVCLInput is used as input to the GetVCL function.
+type GetVCLInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Name is the name of the VCL to fetch (required).
+	Name string
+}
+
+// GetVCL gets the VCL configuration with the given name.
+func (c *Client) GetVCL(i *GetVCLInput) (*VCL, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/vcl/%s", i.Service, i.Version, i.Name)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var v *VCL
+	if err := decodeJSON(&v, resp.Body); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// CreateVCLInput is used as input to the CreateVCL function.
+type CreateVCLInput struct {
+	ServiceID string `form:"service_id"`
+	Version   int    `form:"version"`
+
+	Content string       `form:"content"`
+	Main    *Compatibool `form:"main,omitempty"`
+	Name    string       `form:"name"`
+}
+
+// CreateVCL creates a new VCL file.
+func (c *Client) CreateVCL(i *CreateVCLInput) (*VCL, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/vcl", i.ServiceID, i.Version)
+	resp, err := c.PostForm(path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var v *VCL
+	if err := decodeJSON(&v, resp.Body); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// UpdateVCLInput is used as input to the UpdateVCL function.
+type UpdateVCLInput struct {
+	ServiceID string `form:"service_id"`
+	Version   int    `form:"version"`
+
+	Content string `form:"content"`
+	Name    string `form:"name"`
+}
+
+// UpdateVCL updates a specific VCL file.
+func (c *Client) UpdateVCL(i *UpdateVCLInput) (*VCL, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/vcl/%s", i.ServiceID, i.Version, i.Name)
+	resp, err := c.PutForm(path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var v *VCL
+	if err := decodeJSON(&v, resp.Body); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ActivateVCLInput is used as input to the ActivateVCL function.
+type ActivateVCLInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Name is the name of the VCL to mark as the main entry point (required).
+	Name string
+}
+
+// ActivateVCL sets the VCL with the given name as the main VCL for the
+// configuration version, replacing whichever VCL was previously active.
+func (c *Client) ActivateVCL(i *ActivateVCLInput) (*VCL, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/vcl/%s/main", i.Service, i.Version, i.Name)
+	resp, err := c.Put(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var v *VCL
+	if err := decodeJSON(&v, resp.Body); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// DeleteVCLInput is the input parameter to DeleteVCL.
+type DeleteVCLInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Name is the name of the VCL to delete (required).
+	Name string
+}
+
+// DeleteVCL deletes the VCL file with the given name.
+func (c *Client) DeleteVCL(i *DeleteVCLInput) error {
+	if i.Service == "" {
+		return ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return ErrMissingVersion
+	}
+
+	if i.Name == "" {
+		return ErrMissingName
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/vcl/%s", i.Service, i.Version, i.Name)
+	resp, err := c.Delete(path, nil)
+	if err != nil {
+		return err
+	}
+
+	var r *statusResp
+	if err := decodeJSON(&r, resp.Body); err != nil {
+		return err
+	}
+	if !r.Ok() {
+		return fmt.Errorf("Not Ok: %s", r.Msg)
+	}
+	return nil
+}
+
+// GetGeneratedVCLInput is used as input to the GetGeneratedVCL function.
+type GetGeneratedVCLInput struct {
+	// Service is the ID of the service (required).
+	Service string
+
+	// Version is the specific configuration version (required).
+	Version int
+}
+
+// GetGeneratedVCL returns the VCL rendered by Fastly for the configuration
+// version, after composing boilerplate and any snippets or custom VCL.
+func (c *Client) GetGeneratedVCL(i *GetGeneratedVCLInput) (*VCL, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/generated_vcl", i.Service, i.Version)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var v *VCL
+	if err := decodeJSON(&v, resp.Body); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -1,6 +1,7 @@
 package fastly
 
 import (
+	"crypto/sha1"
 	"fmt"
 	"sort"
 	"time"
@@ -57,6 +58,25 @@ type VCLSnippet struct {
 	DeletedAt *time.Time     `mapstructure:"deleted_at"`
 }
 
+// ContentHash returns a SHA1 hex digest of the snippet's Content, allowing
+// callers to detect drift without diffing the (potentially large) body.
+func (s *VCLSnippet) ContentHash() string {
+	return fmt.Sprintf("%x", sha1.Sum([]byte(s.Content)))
+}
+
+// EqualIgnoringMeta reports whether s and other are the same snippet in
+// every way that matters for deciding whether an update is needed. It skips
+// CreatedAt, UpdatedAt, Version, and ID, which churn on every round trip
+// without describing a real change, and it skips Dynamic too: that flag is
+// fixed at creation time and callers building a desired-state literal (as in
+// SnippetSync) shouldn't have to restate it just to get a stable comparison.
+func (s *VCLSnippet) EqualIgnoringMeta(other *VCLSnippet) bool {
+	return s.Name == other.Name &&
+		s.Type == other.Type &&
+		s.Priority == other.Priority &&
+		s.ContentHash() == other.ContentHash()
+}
+
 // snippetsByName is a sortable list of VCL Snippets.
 type snippetsByName []*VCLSnippet
 
@@ -100,6 +120,125 @@ func (c *Client) ListVCLSnippets(i *ListVCLSnippetsInput) ([]*VCLSnippet, error)
 	return bs, nil
 }
 
+// GetVCLSnippetInput is used as input to the GetVCLSnippet function.
+type GetVCLSnippetInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Name is the name of the snippet to fetch (required).
+	Name string
+}
+
+// GetVCLSnippet gets the VCL snippet with the given name.
+func (c *Client) GetVCLSnippet(i *GetVCLSnippetInput) (*VCLSnippet, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/snippet/%s", i.Service, i.Version, i.Name)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var v *VCLSnippet
+	if err := decodeJSON(&v, resp.Body); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// DynamicVCLSnippet represents a dynamic VCL Snippet response from the
+// Fastly API. Dynamic snippets are not tied to a service version, so their
+// content can be updated independently of cloning and activating a version.
+type DynamicVCLSnippet struct {
+	ServiceID string `mapstructure:"service_id"`
+
+	ID        string     `mapstructure:"snippet_id"`
+	Content   string     `mapstructure:"content"`
+	CreatedAt *time.Time `mapstructure:"created_at"`
+	UpdatedAt *time.Time `mapstructure:"updated_at"`
+}
+
+// GetDynamicVCLSnippetInput is used as input to the GetDynamicVCLSnippet function.
+type GetDynamicVCLSnippetInput struct {
+	// Service is the ID of the service (required).
+	Service string
+
+	// SnippetID is the ID of the dynamic snippet to fetch (required).
+	SnippetID string
+}
+
+// GetDynamicVCLSnippet gets the dynamic VCL snippet with the given ID. Unlike
+// GetVCLSnippet, this is not scoped to a configuration version, since dynamic
+// snippets can be updated independently of versions.
+func (c *Client) GetDynamicVCLSnippet(i *GetDynamicVCLSnippetInput) (*DynamicVCLSnippet, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.SnippetID == "" {
+		return nil, ErrMissingID
+	}
+
+	path := fmt.Sprintf("/service/%s/snippet/%s", i.Service, i.SnippetID)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var v *DynamicVCLSnippet
+	if err := decodeJSON(&v, resp.Body); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// UpdateDynamicVCLSnippetInput is used as input to the
+// UpdateDynamicVCLSnippet function.
+type UpdateDynamicVCLSnippetInput struct {
+	ServiceID string `form:"service_id"`
+	SnippetID string `form:"snippet_id"`
+
+	Content string `form:"content"`
+}
+
+// UpdateDynamicVCLSnippet updates the content of a dynamic VCL snippet
+// without cloning or activating a new service version. This is the intended
+// mechanism for rapidly updating edge logic, such as rotating a blocklist
+// from an automated pipeline.
+func (c *Client) UpdateDynamicVCLSnippet(i *UpdateDynamicVCLSnippetInput) (*DynamicVCLSnippet, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.SnippetID == "" {
+		return nil, ErrMissingID
+	}
+
+	path := fmt.Sprintf("/service/%s/snippet/%s", i.ServiceID, i.SnippetID)
+	resp, err := c.PutForm(path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var v *DynamicVCLSnippet
+	if err := decodeJSON(&v, resp.Body); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
 // CreateVCLSnippetInput is used as input to the CreateVCLSnippet function.
 type CreateVCLSnippetInput struct {
 	ServiceID string `form:"service_id"`
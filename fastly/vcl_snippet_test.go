@@ -2,6 +2,7 @@ package fastly
 
 import (
 	"testing"
+	"time"
 )
 
 func TestClient_VCLSnippets(t *testing.T) {
@@ -27,6 +28,22 @@ func TestClient_VCLSnippets(t *testing.T) {
 	if len(ss) < 1 {
 		t.Errorf("Expected to receive at least one snippet: %v", ss)
 	}
+
+	// Get
+	var s *VCLSnippet
+	record(t, "vcl_snippets/get", func(c *Client) {
+		s, err = c.GetVCLSnippet(&GetVCLSnippetInput{
+			Service: testServiceID,
+			Version: tv.Number,
+			Name:    ss[0].Name,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Name != ss[0].Name {
+		t.Errorf("bad name: %q", s.Name)
+	}
 }
 
 func TestClient_ListVCLSnippet_validation(t *testing.T) {
@@ -36,3 +53,96 @@ func TestClient_ListVCLSnippet_validation(t *testing.T) {
 		t.Errorf("bad error: %s", err)
 	}
 }
+
+func TestClient_GetVCLSnippet_validation(t *testing.T) {
+	var err error
+	_, err = testClient.GetVCLSnippet(&GetVCLSnippetInput{})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetVCLSnippet(&GetVCLSnippetInput{
+		Service: "foo",
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetVCLSnippet(&GetVCLSnippetInput{
+		Service: "foo",
+		Version: 1,
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_GetDynamicVCLSnippet_validation(t *testing.T) {
+	var err error
+	_, err = testClient.GetDynamicVCLSnippet(&GetDynamicVCLSnippetInput{})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetDynamicVCLSnippet(&GetDynamicVCLSnippetInput{
+		Service: "foo",
+	})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestVCLSnippet_ContentHash(t *testing.T) {
+	a := &VCLSnippet{Content: "sub vcl_recv {}"}
+	b := &VCLSnippet{Content: "sub vcl_recv {}"}
+	c := &VCLSnippet{Content: "sub vcl_miss {}"}
+
+	if a.ContentHash() != b.ContentHash() {
+		t.Errorf("expected identical content to hash the same")
+	}
+	if a.ContentHash() == c.ContentHash() {
+		t.Errorf("expected different content to hash differently")
+	}
+}
+
+func TestVCLSnippet_EqualIgnoringMeta(t *testing.T) {
+	now := time.Now()
+	a := &VCLSnippet{
+		ID:        "1",
+		Name:      "test",
+		Type:      VCLSnippetTypeRecv,
+		Priority:  50,
+		Content:   "sub vcl_recv {}",
+		CreatedAt: &now,
+	}
+	b := &VCLSnippet{
+		ID:       "2",
+		Name:     "test",
+		Type:     VCLSnippetTypeRecv,
+		Priority: 50,
+		Content:  "sub vcl_recv {}",
+	}
+	if !a.EqualIgnoringMeta(b) {
+		t.Errorf("expected snippets to be equal ignoring metadata")
+	}
+
+	b.Priority = 100
+	if a.EqualIgnoringMeta(b) {
+		t.Errorf("expected snippets with different priority to differ")
+	}
+}
+
+func TestClient_UpdateDynamicVCLSnippet_validation(t *testing.T) {
+	var err error
+	_, err = testClient.UpdateDynamicVCLSnippet(&UpdateDynamicVCLSnippetInput{})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateDynamicVCLSnippet(&UpdateDynamicVCLSnippetInput{
+		ServiceID: "foo",
+	})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+}
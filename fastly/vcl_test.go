@@ -0,0 +1,253 @@
+package fastly
+
+import (
+	"testing"
+)
+
+func TestClient_VCLs(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var tv *Version
+	record(t, "vcl/version", func(c *Client) {
+		tv = testVersion(t, c)
+	})
+
+	// Create
+	var v *VCL
+	record(t, "vcl/create", func(c *Client) {
+		v, err = c.CreateVCL(&CreateVCLInput{
+			ServiceID: testServiceID,
+			Version:   tv.Number,
+			Name:      "test-vcl",
+			Content:   "sub vcl_recv {\n #FASTLY RECV\n}",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Ensure deleted
+	defer func() {
+		record(t, "vcl/cleanup", func(c *Client) {
+			c.DeleteVCL(&DeleteVCLInput{
+				Service: testServiceID,
+				Version: tv.Number,
+				Name:    "test-vcl",
+			})
+		})
+	}()
+
+	if v.Name != "test-vcl" {
+		t.Errorf("bad name: %q", v.Name)
+	}
+
+	// List
+	var vs []*VCL
+	record(t, "vcl/list", func(c *Client) {
+		vs, err = c.ListVCLs(&ListVCLsInput{
+			Service: testServiceID,
+			Version: tv.Number,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vs) < 1 {
+		t.Errorf("Expected to receive at least one VCL: %v", vs)
+	}
+
+	// Get
+	var nv *VCL
+	record(t, "vcl/get", func(c *Client) {
+		nv, err = c.GetVCL(&GetVCLInput{
+			Service: testServiceID,
+			Version: tv.Number,
+			Name:    "test-vcl",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nv.Content != v.Content {
+		t.Errorf("bad content: %q", nv.Content)
+	}
+
+	// Update
+	var uv *VCL
+	record(t, "vcl/update", func(c *Client) {
+		uv, err = c.UpdateVCL(&UpdateVCLInput{
+			ServiceID: testServiceID,
+			Version:   tv.Number,
+			Name:      "test-vcl",
+			Content:   "sub vcl_recv {\n #FASTLY RECV\n #updated\n}",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uv.Content == v.Content {
+		t.Errorf("bad content: %q", uv.Content)
+	}
+
+	// Activate
+	var av *VCL
+	record(t, "vcl/activate", func(c *Client) {
+		av, err = c.ActivateVCL(&ActivateVCLInput{
+			Service: testServiceID,
+			Version: tv.Number,
+			Name:    "test-vcl",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !av.Main {
+		t.Errorf("expected VCL to be main: %v", av)
+	}
+
+	// Generated VCL
+	record(t, "vcl/generated", func(c *Client) {
+		_, err = c.GetGeneratedVCL(&GetGeneratedVCLInput{
+			Service: testServiceID,
+			Version: tv.Number,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestVCL_ContentHash(t *testing.T) {
+	main := &VCL{Content: "sub vcl_recv {\n #FASTLY RECV\n}"}
+
+	if main.ContentHash() != (&VCL{Content: main.Content}).ContentHash() {
+		t.Errorf("expected two VCLs with the same Content to hash identically")
+	}
+
+	reworded := &VCL{Content: main.Content + "\n#comment"}
+	if main.ContentHash() == reworded.ContentHash() {
+		t.Errorf("expected appending to Content to change the hash")
+	}
+}
+
+func TestVCL_EqualIgnoringMeta(t *testing.T) {
+	base := &VCL{Name: "main.vcl", Main: true, Content: "sub vcl_recv {}"}
+
+	cases := []struct {
+		name  string
+		other *VCL
+		want  bool
+	}{
+		{"identical", &VCL{Name: "main.vcl", Main: true, Content: "sub vcl_recv {}"}, true},
+		{"different metadata only", &VCL{Name: "main.vcl", Main: true, Content: "sub vcl_recv {}", Version: 7}, true},
+		{"different content", &VCL{Name: "main.vcl", Main: true, Content: "sub vcl_recv { #changed }"}, false},
+		{"different main", &VCL{Name: "main.vcl", Main: false, Content: "sub vcl_recv {}"}, false},
+		{"different name", &VCL{Name: "other.vcl", Main: true, Content: "sub vcl_recv {}"}, false},
+	}
+
+	for _, tc := range cases {
+		if got := base.EqualIgnoringMeta(tc.other); got != tc.want {
+			t.Errorf("%s: EqualIgnoringMeta() = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestClient_ListVCLs_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ListVCLs(&ListVCLsInput{})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ListVCLs(&ListVCLsInput{
+		Service: "foo",
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_GetVCL_validation(t *testing.T) {
+	var err error
+	_, err = testClient.GetVCL(&GetVCLInput{})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetVCL(&GetVCLInput{
+		Service: "foo",
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetVCL(&GetVCLInput{
+		Service: "foo",
+		Version: 1,
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_CreateVCL_validation(t *testing.T) {
+	var err error
+	_, err = testClient.CreateVCL(&CreateVCLInput{})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateVCL(&CreateVCLInput{
+		ServiceID: "foo",
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_UpdateVCL_validation(t *testing.T) {
+	var err error
+	_, err = testClient.UpdateVCL(&UpdateVCLInput{})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateVCL(&UpdateVCLInput{
+		ServiceID: "foo",
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateVCL(&UpdateVCLInput{
+		ServiceID: "foo",
+		Version:   1,
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_DeleteVCL_validation(t *testing.T) {
+	var err error
+	err = testClient.DeleteVCL(&DeleteVCLInput{})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = testClient.DeleteVCL(&DeleteVCLInput{
+		Service: "foo",
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = testClient.DeleteVCL(&DeleteVCLInput{
+		Service: "foo",
+		Version: 1,
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+}